@@ -0,0 +1,67 @@
+// Package config loads ghprs's merge policy from a YAML file.
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/policy"
+)
+
+// file is the on-disk shape of config.yaml / .ghprs.yaml.
+type file struct {
+	RequiredChecks          []string `yaml:"required_checks"`
+	MinApprovals            int      `yaml:"min_approvals"`
+	RequiredTeams           []string `yaml:"required_teams"`
+	BlockOnPendingReviewers bool     `yaml:"block_on_pending_reviewers"`
+	AllowedMergeMethods     []string `yaml:"allowed_merge_methods"`
+}
+
+// LoadPolicy loads the merge policy for the current invocation: a per-repo
+// ".ghprs.yaml" takes precedence over "~/.config/ghprs/config.yaml", and
+// policy.Default() is returned if neither exists.
+func LoadPolicy() (policy.Policy, error) {
+	for _, path := range []string{".ghprs.yaml", userConfigPath()} {
+		if path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return policy.Policy{}, err
+		}
+		return parse(data)
+	}
+	return policy.Default(), nil
+}
+
+func userConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "ghprs", "config.yaml")
+}
+
+func parse(data []byte) (policy.Policy, error) {
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return policy.Policy{}, err
+	}
+	methods := make([]forge.MergeMethod, 0, len(f.AllowedMergeMethods))
+	for _, m := range f.AllowedMergeMethods {
+		methods = append(methods, forge.MergeMethod(m))
+	}
+	return policy.Policy{
+		MinApprovals:            f.MinApprovals,
+		RequiredChecks:          f.RequiredChecks,
+		RequiredTeams:           f.RequiredTeams,
+		BlockOnPendingReviewers: f.BlockOnPendingReviewers,
+		AllowedMergeMethods:     methods,
+	}, nil
+}
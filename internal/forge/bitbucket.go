@@ -0,0 +1,150 @@
+package forge
+
+import "fmt"
+
+// bitbucket is the Forge implementation for Bitbucket Cloud.
+type bitbucket struct {
+	token string
+}
+
+func NewBitbucket(token string) Forge {
+	return &bitbucket{token: token}
+}
+
+func (b *bitbucket) GetPR(repo, number string) (PR, error) {
+	var raw struct {
+		ID    int    `json:"id"`
+		Title string `json:"title"`
+		State string `json:"state"` // "OPEN", "MERGED", "DECLINED"
+		Links struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+		} `json:"links"`
+		Source struct {
+			Commit struct {
+				Hash string `json:"hash"`
+			} `json:"commit"`
+		} `json:"source"`
+		Destination struct {
+			Branch struct {
+				Name string `json:"name"`
+			} `json:"branch"`
+		} `json:"destination"`
+		Author struct {
+			Nickname string `json:"nickname"`
+		} `json:"author"`
+	}
+	url := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/pullrequests/%s", repo, number)
+	if err := getJSON(url, b.token, "", &raw); err != nil {
+		return PR{}, err
+	}
+	state := raw.State
+	switch state {
+	case "OPEN":
+		state = "open"
+	case "MERGED":
+		state = "merged"
+	case "DECLINED", "SUPERSEDED":
+		state = "closed"
+	}
+	return PR{
+		Number:  raw.ID,
+		Title:   raw.Title,
+		State:   state,
+		URL:     raw.Links.HTML.Href,
+		HeadSHA: raw.Source.Commit.Hash,
+		Base:    raw.Destination.Branch.Name,
+		Author:  raw.Author.Nickname,
+		// Bitbucket exposes mergeability only via a separate "merge" dry-run
+		// endpoint, so report it as "unknown"; readiness then rests on checks
+		// and reviews alone.
+		MergeableState: "unknown",
+	}, nil
+}
+
+func (b *bitbucket) ListReviews(repo, number string) ([]Review, error) {
+	var raw struct {
+		Participants []struct {
+			User struct {
+				Nickname string `json:"nickname"`
+			} `json:"user"`
+			Approved bool   `json:"approved"`
+			Role     string `json:"role"` // "REVIEWER", "PARTICIPANT"
+			State    string `json:"state"`
+		} `json:"participants"`
+	}
+	url := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/pullrequests/%s", repo, number)
+	if err := getJSON(url, b.token, "", &raw); err != nil {
+		return nil, err
+	}
+	reviews := make([]Review, 0, len(raw.Participants))
+	for _, p := range raw.Participants {
+		if p.Role != "REVIEWER" {
+			continue
+		}
+		state := "requested"
+		switch {
+		case p.Approved:
+			state = "approved"
+		case p.State == "changes_requested":
+			state = "changes_requested"
+		}
+		reviews = append(reviews, Review{User: p.User.Nickname, State: state})
+	}
+	return reviews, nil
+}
+
+func (b *bitbucket) ListChecks(repo, sha string) ([]Check, error) {
+	var raw struct {
+		Values []struct {
+			Key   string `json:"key"`
+			State string `json:"state"` // "SUCCESSFUL", "FAILED", "INPROGRESS", "STOPPED"
+			URL   string `json:"url"`
+		} `json:"values"`
+	}
+	url := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/commit/%s/statuses", repo, sha)
+	if err := getJSON(url, b.token, "", &raw); err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(raw.Values))
+	for _, v := range raw.Values {
+		status, conclusion := bitbucketBuildStatus(v.State)
+		checks = append(checks, Check{Name: v.Key, Status: status, Conclusion: conclusion, URL: v.URL})
+	}
+	return checks, nil
+}
+
+func bitbucketBuildStatus(state string) (ghStatus, ghConclusion string) {
+	switch state {
+	case "SUCCESSFUL":
+		return "completed", "success"
+	case "FAILED", "STOPPED":
+		return "completed", "failure"
+	default:
+		return "in_progress", ""
+	}
+}
+
+func (b *bitbucket) Merge(repo, number string, method MergeMethod) error {
+	if method == MergeRebase {
+		// Bitbucket's "fast_forward" strategy only succeeds if the source is
+		// already a fast-forward of the target; it doesn't rebase new
+		// commits the way a real rebase-and-merge would, so report it as
+		// unsupported rather than silently substituting a different
+		// strategy than the caller asked for.
+		return fmt.Errorf("bitbucket: rebase-and-merge is not supported, use squash or merge")
+	}
+	strategy := "merge_commit"
+	if method == MergeSquash {
+		strategy = "squash"
+	}
+	body := []byte(`{"merge_strategy":"` + strategy + `"}`)
+	url := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/pullrequests/%s/merge", repo, number)
+	return postJSON("POST", url, b.token, body)
+}
+
+func (b *bitbucket) MarkReady(repo, number string) error {
+	// Bitbucket has no draft PR concept; nothing to do.
+	return nil
+}
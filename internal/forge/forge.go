@@ -0,0 +1,148 @@
+// Package forge abstracts over the various git hosting platforms (GitHub,
+// GitLab, Gitea, Bitbucket) so the rest of ghprs doesn't care which one a
+// repo lives on.
+package forge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind identifies which forge backend to use.
+type Kind string
+
+const (
+	GitHub    Kind = "github"
+	GitLab    Kind = "gitlab"
+	Gitea     Kind = "gitea"
+	Bitbucket Kind = "bitbucket"
+)
+
+// PR is the forge-agnostic view of a pull request (or merge request).
+type PR struct {
+	Number         int
+	Title          string
+	Body           string
+	State          string // "open", "closed", "merged"
+	Draft          bool
+	URL            string
+	Mergeable      *bool
+	MergeableState string // "clean", "blocked", "dirty", "unstable", etc.
+	HeadSHA        string
+	Base           string // target branch this PR/MR merges into
+	Author         string
+}
+
+// Review is a single approval/changes-requested/requested entry.
+type Review struct {
+	User  string
+	State string // "approved", "changes_requested", "requested"; GitHub also passes through its other review states lowercased, e.g. "commented", "dismissed"
+	// Team is true when User is actually a team slug that was requested for
+	// review, rather than an individual login.
+	Team bool
+}
+
+// Check is a single CI result (a GitHub check-run, GitLab pipeline job,
+// Gitea status check, or Bitbucket build status).
+type Check struct {
+	Name       string
+	Status     string // "queued", "in_progress", "completed"
+	Conclusion string // "success", "failure", "cancelled", "skipped", "neutral", "timed_out", "action_required"
+	URL        string
+}
+
+// MergeMethod selects how a PR/MR should be merged.
+type MergeMethod string
+
+const (
+	MergeMerge  MergeMethod = "merge"
+	MergeSquash MergeMethod = "squash"
+	MergeRebase MergeMethod = "rebase"
+)
+
+// Forge is implemented by each supported hosting platform.
+type Forge interface {
+	// GetPR fetches the PR/MR itself.
+	GetPR(repo, number string) (PR, error)
+	// ListReviews returns the latest review/approval state per user. The
+	// GitHub and Bitbucket implementations also list anyone still requested
+	// to review; the GitLab and Gitea ones return submitted verdicts only.
+	ListReviews(repo, number string) ([]Review, error)
+	// ListChecks returns the CI status for the PR's head commit.
+	ListChecks(repo, sha string) ([]Check, error)
+	// Merge merges the PR/MR using the given method.
+	Merge(repo, number string, method MergeMethod) error
+	// MarkReady converts a draft PR/MR into one ready for review.
+	MarkReady(repo, number string) error
+}
+
+// PRSummary is the list-view shape used by author/query PR listings.
+type PRSummary struct {
+	Number int
+	Title  string
+	State  string
+	URL    string
+}
+
+// AuthorSearcher is implemented by forges that can list a user's open PRs
+// directly (currently just GitHub).
+type AuthorSearcher interface {
+	SearchOpenByAuthor(repo, author string) ([]PRSummary, error)
+}
+
+// IssueFetcher is implemented by forges that can look up a single issue by
+// number (currently just GitHub).
+type IssueFetcher interface {
+	FetchIssue(repo string, number int) (IssueInfo, error)
+}
+
+// New constructs the Forge implementation for kind, authenticated with
+// token.
+func New(kind Kind, token string) (Forge, error) {
+	switch kind {
+	case GitHub, "":
+		return NewGitHub(token), nil
+	case GitLab:
+		return NewGitLab(token), nil
+	case Gitea:
+		return NewGitea(token), nil
+	case Bitbucket:
+		return NewBitbucket(token), nil
+	default:
+		return nil, fmt.Errorf("unknown forge %q", kind)
+	}
+}
+
+// Detect guesses the forge kind from a repo's host, e.g. "gitlab.com/group/proj".
+// A bare "owner/repo" (no host) is assumed to be GitHub.
+func Detect(repo string) Kind {
+	host := strings.ToLower(hostSegment(repo))
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return GitLab
+	case strings.Contains(host, "gitea"):
+		return Gitea
+	case strings.Contains(host, "bitbucket"):
+		return Bitbucket
+	default:
+		return GitHub
+	}
+}
+
+// hostSegment returns the leading "<host>/" segment of repo if it looks
+// like a host (contains a "."), otherwise "".
+func hostSegment(repo string) string {
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) == 2 && strings.Contains(parts[0], ".") {
+		return parts[0]
+	}
+	return ""
+}
+
+// StripHost removes a leading "<host>/" segment from repo, if any.
+func StripHost(repo string) string {
+	if hostSegment(repo) == "" {
+		return repo
+	}
+	return strings.SplitN(repo, "/", 2)[1]
+}
@@ -0,0 +1,140 @@
+package forge
+
+import "fmt"
+
+// gitea is the Forge implementation for Gitea instances (gitea.com or
+// self-hosted).
+type gitea struct {
+	token   string
+	baseURL string // e.g. "https://gitea.com/api/v1"
+}
+
+func NewGitea(token string) Forge {
+	return &gitea{token: token, baseURL: "https://gitea.com/api/v1"}
+}
+
+func (g *gitea) GetPR(repo, number string) (PR, error) {
+	var raw struct {
+		Number    int    `json:"number"`
+		Title     string `json:"title"`
+		State     string `json:"state"`
+		Draft     bool   `json:"draft"`
+		HTMLURL   string `json:"html_url"`
+		Mergeable bool   `json:"mergeable"`
+		Head      struct {
+			Sha string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", g.baseURL, repo, number)
+	if err := getJSON(url, g.token, "", &raw); err != nil {
+		return PR{}, err
+	}
+	mergeableState := "blocked"
+	if raw.Mergeable {
+		mergeableState = "clean"
+	}
+	return PR{
+		Number:         raw.Number,
+		Title:          raw.Title,
+		State:          raw.State,
+		Draft:          raw.Draft,
+		URL:            raw.HTMLURL,
+		MergeableState: mergeableState,
+		HeadSHA:        raw.Head.Sha,
+		Base:           raw.Base.Ref,
+		Author:         raw.User.Login,
+	}, nil
+}
+
+func (g *gitea) ListReviews(repo, number string) ([]Review, error) {
+	var reviews []struct {
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		State string `json:"state"` // "APPROVED", "REQUEST_CHANGES", "PENDING"
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/reviews", g.baseURL, repo, number)
+	if err := getJSON(url, g.token, "", &reviews); err != nil {
+		return nil, err
+	}
+	latest := map[string]string{}
+	order := []string{}
+	for _, r := range reviews {
+		var state string
+		switch r.State {
+		case "APPROVED":
+			state = "approved"
+		case "REQUEST_CHANGES":
+			state = "changes_requested"
+		default:
+			continue
+		}
+		if _, seen := latest[r.User.Login]; !seen {
+			order = append(order, r.User.Login)
+		}
+		latest[r.User.Login] = state
+	}
+	out := make([]Review, 0, len(order))
+	for _, u := range order {
+		out = append(out, Review{User: u, State: latest[u]})
+	}
+	return out, nil
+}
+
+func (g *gitea) ListChecks(repo, sha string) ([]Check, error) {
+	var statuses []struct {
+		Context string `json:"context"`
+		State   string `json:"status"` // "success", "failure", "error", "pending", "warning"
+		URL     string `json:"target_url"`
+	}
+	url := fmt.Sprintf("%s/repos/%s/commits/%s/statuses", g.baseURL, repo, sha)
+	if err := getJSON(url, g.token, "", &statuses); err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(statuses))
+	for _, s := range statuses {
+		status, conclusion := giteaCommitStatus(s.State)
+		checks = append(checks, Check{Name: s.Context, Status: status, Conclusion: conclusion, URL: s.URL})
+	}
+	return checks, nil
+}
+
+func giteaCommitStatus(state string) (ghStatus, ghConclusion string) {
+	switch state {
+	case "success":
+		return "completed", "success"
+	case "failure", "error":
+		return "completed", "failure"
+	case "warning":
+		return "completed", "neutral"
+	default:
+		return "in_progress", ""
+	}
+}
+
+func (g *gitea) Merge(repo, number string, method MergeMethod) error {
+	style := "merge"
+	switch method {
+	case MergeSquash:
+		style = "squash"
+	case MergeRebase:
+		style = "rebase"
+	}
+	body := []byte(`{"Do":"` + style + `"}`)
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/merge", g.baseURL, repo, number)
+	return postJSON("POST", url, g.token, body)
+}
+
+func (g *gitea) MarkReady(repo, number string) error {
+	// GetPR reads readiness straight from the "draft" field, so flip that
+	// same field rather than "state" (which only tracks open/closed).
+	body := []byte(`{"draft":false}`)
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", g.baseURL, repo, number)
+	return postJSON("PATCH", url, g.token, body)
+}
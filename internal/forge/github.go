@@ -0,0 +1,198 @@
+package forge
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const githubAccept = "application/vnd.github+json"
+
+// gitHub is the Forge implementation for github.com (and, via the same
+// REST shape, GitHub Enterprise).
+type gitHub struct {
+	token string
+}
+
+func NewGitHub(token string) Forge {
+	return &gitHub{token: token}
+}
+
+func (g *gitHub) GetPR(repo, number string) (PR, error) {
+	var raw struct {
+		Number         int    `json:"number"`
+		Title          string `json:"title"`
+		Body           string `json:"body"`
+		State          string `json:"state"`
+		Draft          bool   `json:"draft"`
+		HTMLURL        string `json:"html_url"`
+		Mergeable      *bool  `json:"mergeable"`
+		MergeableState string `json:"mergeable_state"`
+		User           struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Head struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s", repo, number)
+	if err := getJSON(url, g.token, githubAccept, &raw); err != nil {
+		return PR{}, err
+	}
+	return PR{
+		Number:         raw.Number,
+		Title:          raw.Title,
+		Body:           raw.Body,
+		State:          raw.State,
+		Draft:          raw.Draft,
+		URL:            raw.HTMLURL,
+		Mergeable:      raw.Mergeable,
+		MergeableState: raw.MergeableState,
+		HeadSHA:        raw.Head.SHA,
+		Base:           raw.Base.Ref,
+		Author:         raw.User.Login,
+	}, nil
+}
+
+func (g *gitHub) ListReviews(repo, number string) ([]Review, error) {
+	var reviews []struct {
+		User struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		State string `json:"state"`
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/reviews", repo, number)
+	if err := getJSON(url, g.token, githubAccept, &reviews); err != nil {
+		return nil, err
+	}
+	// Collapse to the latest review per user, same as the review state
+	// GitHub itself shows on the PR: a later plain comment doesn't replace
+	// an earlier verdict.
+	latest := map[string]string{}
+	order := []string{}
+	for _, r := range reviews {
+		state := strings.ToLower(r.State)
+		prev, seen := latest[r.User.Login]
+		if !seen {
+			order = append(order, r.User.Login)
+		} else if state == "commented" && prev != "commented" {
+			continue
+		}
+		latest[r.User.Login] = state
+	}
+
+	var reqRev struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+	}
+	_ = getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/requested_reviewers", repo, number), g.token, githubAccept, &reqRev)
+
+	out := make([]Review, 0, len(order)+len(reqRev.Users)+len(reqRev.Teams))
+	for _, u := range order {
+		out = append(out, Review{User: u, State: latest[u]})
+	}
+	for _, u := range reqRev.Users {
+		out = append(out, Review{User: u.Login, State: "requested"})
+	}
+	for _, t := range reqRev.Teams {
+		out = append(out, Review{User: t.Slug, State: "requested", Team: true})
+	}
+	return out, nil
+}
+
+func (g *gitHub) ListChecks(repo, sha string) ([]Check, error) {
+	var raw struct {
+		CheckRuns []struct {
+			Name       string `json:"name"`
+			Status     string `json:"status"`
+			Conclusion string `json:"conclusion"`
+			HTMLURL    string `json:"html_url"`
+		} `json:"check_runs"`
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/commits/%s/check-runs", repo, sha)
+	if err := getJSON(url, g.token, githubAccept, &raw); err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(raw.CheckRuns))
+	for _, c := range raw.CheckRuns {
+		checks = append(checks, Check{
+			Name:       c.Name,
+			Status:     c.Status,
+			Conclusion: c.Conclusion,
+			URL:        c.HTMLURL,
+		})
+	}
+	return checks, nil
+}
+
+func (g *gitHub) Merge(repo, number string, method MergeMethod) error {
+	args := []string{"pr", "merge", number, "--repo", repo, "--auto", "--delete-branch"}
+	switch method {
+	case MergeSquash:
+		args = append(args, "--squash")
+	case MergeRebase:
+		args = append(args, "--rebase")
+	default:
+		args = append(args, "--merge")
+	}
+	return exec.Command("gh", args...).Run()
+}
+
+func (g *gitHub) MarkReady(repo, number string) error {
+	return exec.Command("gh", "pr", "ready", number, "--repo", repo).Run()
+}
+
+// SearchOpenByAuthor lists repo's open PRs authored by author, via GitHub's
+// search API (ghprs's original --author listing query, narrowed to open PRs).
+func (g *gitHub) SearchOpenByAuthor(repo, author string) ([]PRSummary, error) {
+	var data struct {
+		Items []struct {
+			Number  int    `json:"number"`
+			Title   string `json:"title"`
+			State   string `json:"state"`
+			HTMLURL string `json:"html_url"`
+		} `json:"items"`
+	}
+	q := fmt.Sprintf("repo:%s+is:pr+is:open+author:%s", repo, author)
+	url := fmt.Sprintf("https://api.github.com/search/issues?q=%s", q)
+	if err := getJSON(url, g.token, githubAccept, &data); err != nil {
+		return nil, err
+	}
+	out := make([]PRSummary, 0, len(data.Items))
+	for _, it := range data.Items {
+		out = append(out, PRSummary{Number: it.Number, Title: it.Title, State: it.State, URL: it.HTMLURL})
+	}
+	return out, nil
+}
+
+// IssueInfo is the subset of a GitHub issue ghprs needs to render a
+// "Closes on merge" line.
+type IssueInfo struct {
+	Title string
+	State string // "open" or "closed"
+	URL   string
+}
+
+// FetchIssue looks up a single issue by number. It isn't part of the Forge
+// interface (GitLab/Gitea/Bitbucket don't share GitHub's issue-closing
+// keyword conventions), so callers that want it fetch it directly from the
+// GitHub implementation.
+func (g *gitHub) FetchIssue(repo string, number int) (IssueInfo, error) {
+	var raw struct {
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+	}
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%d", repo, number)
+	if err := getJSON(url, g.token, githubAccept, &raw); err != nil {
+		return IssueInfo{}, err
+	}
+	return IssueInfo{Title: raw.Title, State: raw.State, URL: raw.HTMLURL}, nil
+}
@@ -0,0 +1,184 @@
+package forge
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// gitLab is the Forge implementation for gitlab.com (and compatible
+// self-hosted instances). GitLab calls pull requests "merge requests" and
+// identifies them by project path rather than owner/repo.
+type gitLab struct {
+	token string
+}
+
+func NewGitLab(token string) Forge {
+	return &gitLab{token: token}
+}
+
+func (l *gitLab) projectURL(repo string) string {
+	return "https://gitlab.com/api/v4/projects/" + url.PathEscape(repo)
+}
+
+func (l *gitLab) GetPR(repo, number string) (PR, error) {
+	var raw struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		State        string `json:"state"` // "opened", "closed", "merged"
+		Draft        bool   `json:"draft"`
+		WebURL       string `json:"web_url"`
+		MergeStatus  string `json:"merge_status"` // "can_be_merged", "cannot_be_merged", ...
+		SHA          string `json:"sha"`
+		TargetBranch string `json:"target_branch"`
+		Author       struct {
+			Username string `json:"username"`
+		} `json:"author"`
+	}
+	url := fmt.Sprintf("%s/merge_requests/%s", l.projectURL(repo), number)
+	if err := getJSON(url, l.token, "", &raw); err != nil {
+		return PR{}, err
+	}
+	state := raw.State
+	if state == "opened" {
+		state = "open"
+	}
+	return PR{
+		Number:         raw.IID,
+		Title:          raw.Title,
+		State:          state,
+		Draft:          raw.Draft,
+		URL:            raw.WebURL,
+		MergeableState: gitlabMergeableState(raw.MergeStatus),
+		HeadSHA:        raw.SHA,
+		Base:           raw.TargetBranch,
+		Author:         raw.Author.Username,
+	}, nil
+}
+
+// gitlabMergeableState maps GitLab's merge_status onto the GitHub-shaped
+// mergeable_state values the rest of ghprs understands.
+func gitlabMergeableState(status string) string {
+	switch status {
+	case "can_be_merged":
+		return "clean"
+	case "cannot_be_merged", "cannot_be_merged_recheck":
+		return "dirty"
+	default:
+		return "blocked"
+	}
+}
+
+func (l *gitLab) ListReviews(repo, number string) ([]Review, error) {
+	// GitLab MRs are approved as a whole rather than per-reviewer, but the
+	// "approvals" endpoint lists who has approved so far. Reviewers who
+	// have not approved yet are not listed.
+	var approvals struct {
+		ApprovedBy []struct {
+			User struct {
+				Username string `json:"username"`
+			} `json:"user"`
+		} `json:"approved_by"`
+	}
+	url := fmt.Sprintf("%s/merge_requests/%s/approvals", l.projectURL(repo), number)
+	if err := getJSON(url, l.token, "", &approvals); err != nil {
+		return nil, err
+	}
+	reviews := make([]Review, 0, len(approvals.ApprovedBy))
+	for _, a := range approvals.ApprovedBy {
+		reviews = append(reviews, Review{User: a.User.Username, State: "approved"})
+	}
+	return reviews, nil
+}
+
+func (l *gitLab) ListChecks(repo, sha string) ([]Check, error) {
+	// Pipelines are GitLab's equivalent of check-runs; filter to the ones
+	// for this commit SHA.
+	var pipelines []struct {
+		ID     int    `json:"id"`
+		Status string `json:"status"` // "success", "failed", "running", "pending", "canceled", "skipped"
+		SHA    string `json:"sha"`
+		WebURL string `json:"web_url"`
+	}
+	url := fmt.Sprintf("%s/pipelines?sha=%s", l.projectURL(repo), sha)
+	if err := getJSON(url, l.token, "", &pipelines); err != nil {
+		return nil, err
+	}
+	checks := make([]Check, 0, len(pipelines))
+	for _, p := range pipelines {
+		if p.SHA != sha {
+			continue
+		}
+		status, conclusion := gitlabPipelineStatus(p.Status)
+		checks = append(checks, Check{
+			Name:       fmt.Sprintf("pipeline #%d", p.ID),
+			Status:     status,
+			Conclusion: conclusion,
+			URL:        p.WebURL,
+		})
+	}
+	return checks, nil
+}
+
+// gitlabPipelineStatus maps a GitLab pipeline status onto the
+// status/conclusion pair GitHub check-runs use.
+func gitlabPipelineStatus(status string) (ghStatus, ghConclusion string) {
+	switch status {
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped":
+		return "completed", "skipped"
+	case "running", "pending", "created":
+		return "in_progress", ""
+	default:
+		return "in_progress", ""
+	}
+}
+
+func (l *gitLab) Merge(repo, number string, method MergeMethod) error {
+	if method == MergeRebase {
+		// GitLab has no "rebase and merge" on the merge endpoint; rebasing is
+		// a separate async operation that fast-forwards the source branch
+		// onto the target, after which the MR still has to be merged as a
+		// normal merge commit. Report it as unsupported rather than silently
+		// performing a different merge strategy than the caller asked for.
+		return fmt.Errorf("gitlab: rebase-and-merge is not supported, use squash or merge")
+	}
+	body := []byte(`{"squash":` + boolString(method == MergeSquash) + `}`)
+	url := fmt.Sprintf("%s/merge_requests/%s/merge", l.projectURL(repo), number)
+	return postJSON("PUT", url, l.token, body)
+}
+
+func (l *gitLab) MarkReady(repo, number string) error {
+	// GitLab marks readiness by stripping the "Draft:" title prefix rather
+	// than a dedicated state field.
+	var raw struct {
+		Title string `json:"title"`
+	}
+	getURL := fmt.Sprintf("%s/merge_requests/%s", l.projectURL(repo), number)
+	if err := getJSON(getURL, l.token, "", &raw); err != nil {
+		return err
+	}
+	title := strings.TrimPrefix(raw.Title, "Draft: ")
+	title = strings.TrimPrefix(title, "Draft:")
+	body := []byte(`{"title":"` + jsonEscape(title) + `"}`)
+	putURL := fmt.Sprintf("%s/merge_requests/%s", l.projectURL(repo), number)
+	return postJSON("PUT", putURL, l.token, body)
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func jsonEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return s
+}
@@ -0,0 +1,58 @@
+package forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/santileira/github-cli/internal/httpcache"
+)
+
+// cache is the shared, disk-backed HTTP cache used by getJSON.
+var cache = httpcache.New("")
+
+// LastRateLimit returns the rate-limit info observed on the most recent
+// getJSON call, for forges (currently just GitHub) that report it.
+func LastRateLimit() httpcache.RateLimit {
+	return cache.LastRateLimit()
+}
+
+// getJSON fetches url with an optional bearer token and decodes the JSON
+// body into target, reusing a cached response on a 304.
+func getJSON(url, token, accept string, target any) error {
+	body, _, err := cache.Get(url, token, accept)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, target)
+}
+
+// postJSON posts body (already-encoded JSON, may be nil) to url and
+// discards the response body, returning an error on non-2xx status.
+// Mutations are never cached.
+func postJSON(method, url, token string, body []byte) error {
+	var req *http.Request
+	var err error
+	if body != nil {
+		req, err = http.NewRequest(method, url, bytes.NewReader(body))
+	} else {
+		req, err = http.NewRequest(method, url, nil)
+	}
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("forge API error: %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Package httpcache wraps HTTP GETs with ETag/Last-Modified conditional
+// requests, persisting responses to disk so repeated polling doesn't burn
+// rate-limit quota on requests that come back unchanged.
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// entry is what gets persisted to disk per cached URL.
+type entry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Body         []byte    `json:"body"`
+	StatusCode   int       `json:"status_code"`
+	FetchedAt    time.Time `json:"fetched_at"`
+}
+
+// RateLimit is parsed from GitHub's X-RateLimit-* response headers.
+type RateLimit struct {
+	Remaining int
+	Limit     int
+	Reset     time.Time
+}
+
+// Client performs cached, conditional GETs against a directory of
+// persisted responses.
+type Client struct {
+	HTTP *http.Client
+	Dir  string // e.g. ~/.cache/ghprs
+
+	mu            sync.Mutex // guards lastRateLimit, since queue.Tick fires Get from many goroutines at once
+	lastRateLimit RateLimit
+}
+
+// New returns a Client that persists cache entries under dir. If dir is
+// empty, it defaults to ~/.cache/ghprs.
+func New(dir string) *Client {
+	if dir == "" {
+		dir = DefaultDir()
+	}
+	return &Client{HTTP: http.DefaultClient, Dir: dir}
+}
+
+// DefaultDir returns ~/.cache/ghprs, falling back to a relative .ghprs-cache
+// if the home directory can't be determined.
+func DefaultDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".ghprs-cache"
+	}
+	return filepath.Join(home, ".cache", "ghprs")
+}
+
+// cacheKey hashes the URL to a filesystem-safe filename.
+func cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *Client) path(url string) string {
+	return filepath.Join(c.Dir, cacheKey(url)+".json")
+}
+
+func (c *Client) load(url string) (*entry, bool) {
+	data, err := os.ReadFile(c.path(url))
+	if err != nil {
+		return nil, false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+	return &e, true
+}
+
+func (c *Client) save(e *entry) {
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path(e.URL), data, 0o644)
+}
+
+// Get performs a conditional GET: if a cached entry exists for url, it
+// sends If-None-Match/If-Modified-Since and returns the cached body on a
+// 304, otherwise it fetches fresh and updates the cache.
+func (c *Client) Get(url, token, accept string) (body []byte, headers http.Header, err error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	cached, hasCached := c.load(url)
+	if hasCached {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	res, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	c.mu.Lock()
+	c.lastRateLimit = parseRateLimit(res.Header)
+	c.mu.Unlock()
+
+	if res.StatusCode == http.StatusNotModified && hasCached {
+		return cached.Body, res.Header, nil
+	}
+	if res.StatusCode >= 300 {
+		return nil, res.Header, fmt.Errorf("forge API error: %s", res.Status)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, res.Header, err
+	}
+
+	c.save(&entry{
+		URL:          url,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		Body:         b,
+		StatusCode:   res.StatusCode,
+		FetchedAt:    time.Now(),
+	})
+
+	return b, res.Header, nil
+}
+
+// LastRateLimit returns the rate-limit info observed on the most recent
+// request made through this client.
+func (c *Client) LastRateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastRateLimit
+}
+
+func parseRateLimit(h http.Header) RateLimit {
+	var rl RateLimit
+	if v := h.Get("X-RateLimit-Remaining"); v != "" {
+		rl.Remaining, _ = strconv.Atoi(v)
+	}
+	if v := h.Get("X-RateLimit-Limit"); v != "" {
+		rl.Limit, _ = strconv.Atoi(v)
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+			rl.Reset = time.Unix(sec, 0)
+		}
+	}
+	return rl
+}
+
+// ShouldBackOff reports whether remaining quota has dropped below a small
+// safety margin before the window resets.
+func (rl RateLimit) ShouldBackOff() bool {
+	return rl.Limit > 0 && rl.Remaining <= rl.Limit/20 && time.Now().Before(rl.Reset)
+}
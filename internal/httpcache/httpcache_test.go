@@ -0,0 +1,114 @@
+package httpcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetCachesAndRevalidates(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("hello"))
+	}))
+	defer srv.Close()
+
+	c := New(t.TempDir())
+
+	body, _, err := c.Get(srv.URL, "", "")
+	if err != nil {
+		t.Fatalf("first Get: %v", err)
+	}
+	if string(body) != "hello" {
+		t.Errorf("first Get body = %q, want %q", body, "hello")
+	}
+	if requests != 1 {
+		t.Fatalf("requests after first Get = %d, want 1", requests)
+	}
+
+	body, _, err = c.Get(srv.URL, "", "")
+	if err != nil {
+		t.Fatalf("second Get: %v", err)
+	}
+	if string(body) != "hello" {
+		t.Errorf("second Get body (from 304) = %q, want %q", body, "hello")
+	}
+	if requests != 2 {
+		t.Fatalf("requests after second Get = %d, want 2 (expected a conditional request)", requests)
+	}
+}
+
+func TestGetSendsIfNoneMatch(t *testing.T) {
+	var gotIfNoneMatch string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			gotIfNoneMatch = r.Header.Get("If-None-Match")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	c := New(t.TempDir())
+	if _, _, err := c.Get(srv.URL, "", ""); err != nil {
+		t.Fatalf("first Get: %v", err)
+	}
+	if _, _, err := c.Get(srv.URL, "", ""); err != nil {
+		t.Fatalf("second Get: %v", err)
+	}
+	if gotIfNoneMatch != `"abc"` {
+		t.Errorf("If-None-Match on revalidation = %q, want %q", gotIfNoneMatch, `"abc"`)
+	}
+}
+
+func TestGetConcurrentIsRaceFree(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "10")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	c := New(t.TempDir())
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.Get(srv.URL, "", ""); err != nil {
+				t.Errorf("Get: %v", err)
+			}
+			c.LastRateLimit()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestShouldBackOff(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	cases := []struct {
+		name string
+		rl   RateLimit
+		want bool
+	}{
+		{"plenty of quota", RateLimit{Limit: 5000, Remaining: 4000, Reset: future}, false},
+		{"below safety margin", RateLimit{Limit: 5000, Remaining: 100, Reset: future}, true},
+		{"no limit known", RateLimit{Limit: 0, Remaining: 0, Reset: future}, false},
+		{"already past reset", RateLimit{Limit: 5000, Remaining: 0, Reset: time.Now().Add(-time.Minute)}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.rl.ShouldBackOff(); got != tc.want {
+			t.Errorf("%s: ShouldBackOff() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Package issues finds the GitHub issues a PR will auto-close on merge, by
+// scanning its body for closing keywords.
+package issues
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Ref is a single issue reference found in a PR body, e.g. "Fixes #23" or
+// "Fixes owner/repo#45".
+type Ref struct {
+	Owner  string // empty if the reference didn't specify a repo
+	Repo   string // empty if the reference didn't specify a repo
+	Number int
+}
+
+// closingKeywordsPattern mirrors the set GitHub itself recognizes for
+// auto-closing linked issues.
+var closingKeywordsPattern = `clos(?:e|es|ed)|fix(?:es|ed)?|resolve(?:s|d)?`
+
+// refPattern matches "#123" or "owner/repo#123" immediately (modulo
+// whitespace and colons) after a closing keyword.
+var refPattern = regexp.MustCompile(
+	`(?i)\b(?:` + closingKeywordsPattern + `)\b[:\s]+((?:[\w.-]+/[\w.-]+)?#(\d+))`,
+)
+
+// ParsePRFixes scans body for GitHub closing keywords (close/closes/closed,
+// fix/fixes/fixed, resolve/resolves/resolved) followed by "#<num>" or
+// "owner/repo#<num>", case-insensitively and word-boundary aware.
+func ParsePRFixes(body string) []Ref {
+	matches := refPattern.FindAllStringSubmatch(body, -1)
+	var refs []Ref
+	for _, m := range matches {
+		ref, full := m[1], m[2]
+		n, err := strconv.Atoi(full)
+		if err != nil {
+			continue
+		}
+		var owner, repo string
+		if idx := strings.IndexByte(ref, '#'); idx > 0 {
+			ownerRepo := ref[:idx]
+			if slash := strings.IndexByte(ownerRepo, '/'); slash >= 0 {
+				owner, repo = ownerRepo[:slash], ownerRepo[slash+1:]
+			}
+		}
+		refs = append(refs, Ref{Owner: owner, Repo: repo, Number: n})
+	}
+	return refs
+}
+
+// String renders a Ref the way it would appear in a PR body, e.g. "#23" or
+// "owner/repo#45".
+func (r Ref) String() string {
+	if r.Owner != "" && r.Repo != "" {
+		return r.Owner + "/" + r.Repo + "#" + strconv.Itoa(r.Number)
+	}
+	return "#" + strconv.Itoa(r.Number)
+}
@@ -0,0 +1,63 @@
+package issues
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePRFixes(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want []Ref
+	}{
+		{
+			name: "multiple keywords, mixed case",
+			body: "Fixes #23 and FIXES #45",
+			want: []Ref{{Number: 23}, {Number: 45}},
+		},
+		{
+			name: "keyword not word-bounded doesn't match",
+			body: "fixxx #99",
+			want: nil,
+		},
+		{
+			name: "missing # doesn't match",
+			body: "resolve 345",
+			want: nil,
+		},
+		{
+			name: "owner/repo#number",
+			body: "Closes owner/repo#12",
+			want: []Ref{{Owner: "owner", Repo: "repo", Number: 12}},
+		},
+		{
+			name: "no closing keyword",
+			body: "See #23 for background",
+			want: nil,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ParsePRFixes(tc.body)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParsePRFixes(%q) = %#v, want %#v", tc.body, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRefString(t *testing.T) {
+	cases := []struct {
+		ref  Ref
+		want string
+	}{
+		{Ref{Number: 23}, "#23"},
+		{Ref{Owner: "owner", Repo: "repo", Number: 12}, "owner/repo#12"},
+	}
+	for _, tc := range cases {
+		if got := tc.ref.String(); got != tc.want {
+			t.Errorf("Ref(%+v).String() = %q, want %q", tc.ref, got, tc.want)
+		}
+	}
+}
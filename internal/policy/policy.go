@@ -0,0 +1,145 @@
+// Package policy evaluates whether a PR is merge-ready against a
+// configurable set of rules.
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/santileira/github-cli/internal/forge"
+)
+
+// Policy configures what "merge-ready" means.
+type Policy struct {
+	MinApprovals            int
+	RequiredChecks          []string // empty means "all reported checks must be green"
+	RequiredTeams           []string
+	BlockOnPendingReviewers bool
+	AllowedMergeMethods     []forge.MergeMethod // empty means "any method"
+}
+
+// Default returns the policy ghprs used before config files existed: one
+// approval, every reported check green, pending reviewers don't block.
+func Default() Policy {
+	return Policy{MinApprovals: 1}
+}
+
+// Allows reports whether method is permitted by the policy.
+func (p Policy) Allows(method forge.MergeMethod) bool {
+	if len(p.AllowedMergeMethods) == 0 {
+		return true
+	}
+	for _, m := range p.AllowedMergeMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate reports whether pr is merge-ready under the policy, and if not,
+// a human-readable reason per unmet condition naming the specific check or
+// approver that's missing.
+func Evaluate(p Policy, pr forge.PR, reviews []forge.Review, checks []forge.Check) (ready bool, reasons []string) {
+	if strings.ToLower(pr.State) != "open" {
+		reasons = append(reasons, fmt.Sprintf("PR is %s (must be open)", pr.State))
+	}
+	if s := strings.ToLower(pr.MergeableState); s != "" && s != "unknown" && s != "clean" {
+		reasons = append(reasons, fmt.Sprintf("mergeable_state is %s (must be clean)", pr.MergeableState))
+	}
+
+	approvals := 0
+	pendingTeams := map[string]bool{}
+	for _, r := range reviews {
+		switch {
+		case r.State == "approved" && !r.Team:
+			approvals++
+		case r.State == "changes_requested":
+			reasons = append(reasons, fmt.Sprintf("%s requested changes", r.User))
+		case r.State == "requested" && r.Team:
+			pendingTeams[r.User] = true
+		case r.State == "requested" && p.BlockOnPendingReviewers:
+			reasons = append(reasons, fmt.Sprintf("waiting on review from %s", r.User))
+		}
+	}
+
+	minApprovals := p.MinApprovals
+	if minApprovals <= 0 {
+		minApprovals = 1
+	}
+	if approvals < minApprovals {
+		reasons = append(reasons, fmt.Sprintf("needs %d approval(s), has %d", minApprovals, approvals))
+	}
+
+	for _, team := range p.RequiredTeams {
+		if pendingTeams[team] {
+			reasons = append(reasons, fmt.Sprintf("waiting on required review from team %s", team))
+		}
+	}
+
+	byName := map[string]forge.Check{}
+	for _, c := range checks {
+		byName[c.Name] = c
+	}
+	required := p.RequiredChecks
+	if len(required) == 0 {
+		for _, c := range checks {
+			required = append(required, c.Name)
+		}
+	}
+	for _, name := range required {
+		c, ok := byName[name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("required check %q has not reported", name))
+			continue
+		}
+		if !CheckGreen(c) {
+			status := c.Conclusion
+			if status == "" {
+				status = c.Status
+			}
+			reasons = append(reasons, fmt.Sprintf("check %q is %s", c.Name, status))
+		}
+	}
+
+	return len(reasons) == 0, reasons
+}
+
+// CheckGreen reports whether a single check counts as passing: completed
+// with a success, neutral, or skipped conclusion.
+func CheckGreen(c forge.Check) bool {
+	if strings.ToLower(c.Status) != "completed" {
+		return false
+	}
+	switch strings.ToLower(c.Conclusion) {
+	case "success", "neutral", "skipped":
+		return true
+	default:
+		return false
+	}
+}
+
+// ChecksGreen reports whether every check the policy requires (or every
+// reported check, if the policy doesn't narrow it down) is green.
+func ChecksGreen(p Policy, checks []forge.Check) bool {
+	byName := map[string]forge.Check{}
+	for _, c := range checks {
+		byName[c.Name] = c
+	}
+	required := p.RequiredChecks
+	if len(required) == 0 {
+		for _, c := range checks {
+			if !CheckGreen(c) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range required {
+		c, ok := byName[name]
+		if !ok || !CheckGreen(c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/santileira/github-cli/internal/forge"
+)
+
+func openPR() forge.PR {
+	return forge.PR{State: "open", MergeableState: "clean"}
+}
+
+func TestEvaluateReadyOnDefault(t *testing.T) {
+	reviews := []forge.Review{{User: "alice", State: "approved"}}
+	checks := []forge.Check{{Name: "ci", Status: "completed", Conclusion: "success"}}
+	ready, reasons := Evaluate(Default(), openPR(), reviews, checks)
+	if !ready {
+		t.Fatalf("expected ready, got not-ready: %v", reasons)
+	}
+}
+
+func TestEvaluateNotReadyMissingApprovals(t *testing.T) {
+	p := Policy{MinApprovals: 2}
+	reviews := []forge.Review{{User: "alice", State: "approved"}}
+	ready, reasons := Evaluate(p, openPR(), reviews, nil)
+	if ready {
+		t.Fatal("expected not ready with only 1 of 2 required approvals")
+	}
+	if len(reasons) == 0 {
+		t.Fatal("expected a reason naming the missing approval count")
+	}
+}
+
+func TestEvaluateNotReadyChangesRequested(t *testing.T) {
+	reviews := []forge.Review{
+		{User: "alice", State: "approved"},
+		{User: "bob", State: "changes_requested"},
+	}
+	ready, reasons := Evaluate(Default(), openPR(), reviews, nil)
+	if ready {
+		t.Fatal("expected not ready when a reviewer requested changes")
+	}
+	found := false
+	for _, r := range reasons {
+		if r == "bob requested changes" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected a reason naming bob, got %v", reasons)
+	}
+}
+
+func TestEvaluateNotReadyMissingRequiredCheck(t *testing.T) {
+	p := Policy{MinApprovals: 1, RequiredChecks: []string{"ci", "lint"}}
+	reviews := []forge.Review{{User: "alice", State: "approved"}}
+	checks := []forge.Check{{Name: "ci", Status: "completed", Conclusion: "success"}}
+	ready, reasons := Evaluate(p, openPR(), reviews, checks)
+	if ready {
+		t.Fatal("expected not ready when required check \"lint\" hasn't reported")
+	}
+	found := false
+	for _, r := range reasons {
+		if r == `required check "lint" has not reported` {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected a reason naming the missing check, got %v", reasons)
+	}
+}
+
+func TestEvaluateNotReadyPendingTeam(t *testing.T) {
+	p := Policy{MinApprovals: 1, RequiredTeams: []string{"reviewers"}}
+	reviews := []forge.Review{
+		{User: "alice", State: "approved"},
+		{User: "reviewers", State: "requested", Team: true},
+	}
+	ready, reasons := Evaluate(p, openPR(), reviews, nil)
+	if ready {
+		t.Fatal("expected not ready while a required team review is still pending")
+	}
+	if len(reasons) == 0 {
+		t.Error("expected a reason naming the pending team")
+	}
+}
+
+func TestEvaluateNotReadyWhenNotOpen(t *testing.T) {
+	pr := openPR()
+	pr.State = "closed"
+	ready, reasons := Evaluate(Default(), pr, []forge.Review{{User: "alice", State: "approved"}}, nil)
+	if ready {
+		t.Fatal("expected not ready for a closed PR")
+	}
+	if len(reasons) == 0 {
+		t.Error("expected a reason for the non-open state")
+	}
+}
+
+func TestChecksGreen(t *testing.T) {
+	checks := []forge.Check{
+		{Name: "ci", Status: "completed", Conclusion: "success"},
+		{Name: "lint", Status: "completed", Conclusion: "failure"},
+	}
+	if ChecksGreen(Policy{}, checks) {
+		t.Error("expected ChecksGreen false when any reported check is failing")
+	}
+	if !ChecksGreen(Policy{RequiredChecks: []string{"ci"}}, checks) {
+		t.Error("expected ChecksGreen true when narrowed to the passing check only")
+	}
+}
+
+func TestCheckGreen(t *testing.T) {
+	cases := []struct {
+		name string
+		c    forge.Check
+		want bool
+	}{
+		{"success", forge.Check{Status: "completed", Conclusion: "success"}, true},
+		{"neutral", forge.Check{Status: "completed", Conclusion: "neutral"}, true},
+		{"skipped", forge.Check{Status: "completed", Conclusion: "skipped"}, true},
+		{"failure", forge.Check{Status: "completed", Conclusion: "failure"}, false},
+		{"in progress", forge.Check{Status: "in_progress"}, false},
+	}
+	for _, tc := range cases {
+		if got := CheckGreen(tc.c); got != tc.want {
+			t.Errorf("%s: CheckGreen() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
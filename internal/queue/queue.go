@@ -0,0 +1,243 @@
+// Package queue implements a submit-queue style batch watcher that merges
+// each watched PR as soon as it becomes merge-ready.
+package queue
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/policy"
+)
+
+// Policy configures what "merge-ready" means for the queue: the shared
+// policy.Policy plus a queue-specific staleness rule.
+type Policy struct {
+	policy.Policy
+	// StaleAfter re-triggers CI (via StaleCheck) when the last green run for
+	// a PR is older than this, counted from the tick on which the queue first
+	// saw its checks turn green.
+	StaleAfter time.Duration
+}
+
+// StaleCheck re-kicks CI for a PR whose last success is stale, e.g. by
+// posting a comment and calling `gh pr checks --watch`.
+type StaleCheck func(repo string, pr forge.PR) error
+
+// Item is the queue's view of a single watched PR.
+type Item struct {
+	Repo   string
+	Number string
+
+	mu            sync.Mutex
+	pr            forge.PR
+	reviews       []forge.Review
+	checks        []forge.Check
+	lastGreenAt   time.Time
+	green         bool // whether the checks were green on the last evaluation
+	ready         bool
+	notReady      []string
+	merged        bool
+	err           error
+	staleNotified bool // whether OnStale has already fired for the current staleness episode
+}
+
+// Status is the JSON-serializable snapshot of an Item, suitable for
+// embedding in dashboards.
+type Status struct {
+	Repo     string   `json:"repo"`
+	Number   string   `json:"number"`
+	Title    string   `json:"title"`
+	State    string   `json:"state"`
+	Ready    bool     `json:"ready"`
+	Merged   bool     `json:"merged"`
+	NotReady []string `json:"not_ready,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// Queue watches a set of items and merges each one as soon as it's ready.
+type Queue struct {
+	Forge     forge.Forge
+	Policy    Policy
+	OnStale   StaleCheck
+	MergeWith forge.MergeMethod
+
+	mu        sync.Mutex
+	items     []*Item
+	baseLocks map[string]*sync.Mutex // serialize merges per "repo/base-branch" key
+}
+
+// New creates a Queue that will watch the given repo/PR-number pairs.
+func New(f forge.Forge, policy Policy, method forge.MergeMethod) *Queue {
+	return &Queue{
+		Forge:     f,
+		Policy:    policy,
+		MergeWith: method,
+		baseLocks: map[string]*sync.Mutex{},
+	}
+}
+
+// Add registers a PR to be watched by the queue.
+func (q *Queue) Add(repo, number string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = append(q.items, &Item{Repo: repo, Number: number})
+}
+
+// rank orders items for display: failing items first, ready-to-merge last.
+func rank(notReady []string, ready bool) int {
+	switch {
+	case len(notReady) > 0 && containsFailure(notReady):
+		return 0
+	case ready:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func containsFailure(reasons []string) bool {
+	for _, r := range reasons {
+		if strings.Contains(strings.ToLower(r), "fail") {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate fetches fresh state for an item and evaluates the policy,
+// recording why it isn't ready (if it isn't).
+func (q *Queue) evaluate(it *Item) {
+	pr, err := q.Forge.GetPR(it.Repo, it.Number)
+	if err != nil {
+		it.mu.Lock()
+		it.err = err
+		it.mu.Unlock()
+		return
+	}
+	reviews, _ := q.Forge.ListReviews(it.Repo, it.Number)
+	checks, _ := q.Forge.ListChecks(it.Repo, pr.HeadSHA)
+
+	ready, reasons := policy.Evaluate(q.Policy.Policy, pr, reviews, checks)
+
+	it.mu.Lock()
+	it.pr, it.reviews, it.checks, it.err = pr, reviews, checks, nil
+	green := policy.ChecksGreen(q.Policy.Policy, checks)
+	if green && !it.green {
+		it.lastGreenAt = time.Now()
+		it.staleNotified = false
+	}
+	it.green = green
+	it.ready = ready
+	it.notReady = reasons
+	it.mu.Unlock()
+
+	if q.OnStale != nil && q.Policy.StaleAfter > 0 {
+		it.mu.Lock()
+		stale := it.green && !it.lastGreenAt.IsZero() && time.Since(it.lastGreenAt) > q.Policy.StaleAfter && !it.staleNotified
+		if stale {
+			it.staleNotified = true
+		}
+		it.mu.Unlock()
+		if stale {
+			_ = q.OnStale(it.Repo, pr)
+		}
+	}
+}
+
+// baseLock returns (creating if needed) the mutex that serializes merges
+// against a given "repo/base-branch" key.
+func (q *Queue) baseLock(key string) *sync.Mutex {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	l, ok := q.baseLocks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		q.baseLocks[key] = l
+	}
+	return l
+}
+
+// Tick evaluates every item concurrently and merges any that are ready.
+func (q *Queue) Tick() {
+	var wg sync.WaitGroup
+	for _, it := range q.items {
+		it := it
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			it.mu.Lock()
+			alreadyMerged := it.merged
+			it.mu.Unlock()
+			if alreadyMerged {
+				return
+			}
+			q.evaluate(it)
+
+			it.mu.Lock()
+			ready, base := it.ready, it.pr.Base
+			it.mu.Unlock()
+			if !ready {
+				return
+			}
+
+			method := q.MergeWith
+			if !q.Policy.Allows(method) {
+				it.mu.Lock()
+				it.err = fmt.Errorf("merge method %q not allowed by policy", method)
+				it.mu.Unlock()
+				return
+			}
+
+			lock := q.baseLock(it.Repo + "/" + base)
+			lock.Lock()
+			defer lock.Unlock()
+
+			if err := q.Forge.Merge(it.Repo, it.Number, method); err != nil {
+				it.mu.Lock()
+				it.err = err
+				it.mu.Unlock()
+				return
+			}
+			it.mu.Lock()
+			it.merged = true
+			it.mu.Unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+// Status returns a JSON-serializable snapshot of every item, ordered by
+// rank (failures first, ready last).
+func (q *Queue) Status() []Status {
+	q.mu.Lock()
+	items := append([]*Item(nil), q.items...)
+	q.mu.Unlock()
+
+	out := make([]Status, 0, len(items))
+	for _, it := range items {
+		it.mu.Lock()
+		s := Status{
+			Repo:   it.Repo,
+			Number: it.Number,
+			Title:  it.pr.Title,
+			State:  it.pr.State,
+			Ready:  it.ready,
+			Merged: it.merged,
+		}
+		if it.err != nil {
+			s.Error = it.err.Error()
+		} else if !it.merged {
+			s.NotReady = it.notReady
+		}
+		it.mu.Unlock()
+		out = append(out, s)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return rank(out[i].NotReady, out[i].Ready) < rank(out[j].NotReady, out[j].Ready)
+	})
+	return out
+}
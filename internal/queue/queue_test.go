@@ -0,0 +1,86 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/policy"
+)
+
+func policyAllowing(methods ...forge.MergeMethod) policy.Policy {
+	return policy.Policy{MinApprovals: 1, AllowedMergeMethods: methods}
+}
+
+// fakeForge is a minimal forge.Forge stub for exercising Tick without
+// hitting a real API.
+type fakeForge struct {
+	pr         forge.PR
+	mergeCalls []forge.MergeMethod
+	mergeErr   error
+}
+
+func (f *fakeForge) GetPR(repo, number string) (forge.PR, error) { return f.pr, nil }
+func (f *fakeForge) ListReviews(repo, number string) ([]forge.Review, error) {
+	return []forge.Review{{User: "alice", State: "approved"}}, nil
+}
+func (f *fakeForge) ListChecks(repo, sha string) ([]forge.Check, error) { return nil, nil }
+func (f *fakeForge) Merge(repo, number string, method forge.MergeMethod) error {
+	f.mergeCalls = append(f.mergeCalls, method)
+	return f.mergeErr
+}
+func (f *fakeForge) MarkReady(repo, number string) error { return nil }
+
+func TestTickRefusesMergeMethodNotAllowedByPolicy(t *testing.T) {
+	f := &fakeForge{pr: forge.PR{State: "open", MergeableState: "clean", Base: "main"}}
+	q := New(f, Policy{Policy: policyAllowing(forge.MergeMerge)}, forge.MergeSquash)
+	q.Add("o/r", "1")
+
+	q.Tick()
+
+	if len(f.mergeCalls) != 0 {
+		t.Fatalf("Merge called with disallowed method: %v", f.mergeCalls)
+	}
+	status := q.Status()[0]
+	if status.Error == "" {
+		t.Error("expected an error recorded when the configured merge method is disallowed")
+	}
+}
+
+func TestTickUsesAllowedMergeMethod(t *testing.T) {
+	f := &fakeForge{pr: forge.PR{State: "open", MergeableState: "clean", Base: "main"}}
+	q := New(f, Policy{Policy: policyAllowing(forge.MergeSquash)}, forge.MergeSquash)
+	q.Add("o/r", "1")
+
+	q.Tick()
+
+	if len(f.mergeCalls) != 1 || f.mergeCalls[0] != forge.MergeSquash {
+		t.Fatalf("mergeCalls = %v, want one squash merge", f.mergeCalls)
+	}
+}
+
+func TestRank(t *testing.T) {
+	cases := []struct {
+		name     string
+		notReady []string
+		ready    bool
+		want     int
+	}{
+		{"failing check sorts first", []string{`check "ci" is failure`}, false, 0},
+		{"ready sorts last", nil, true, 2},
+		{"waiting, not failing, sorts middle", []string{"needs 1 approval(s), has 0"}, false, 1},
+	}
+	for _, tc := range cases {
+		if got := rank(tc.notReady, tc.ready); got != tc.want {
+			t.Errorf("%s: rank(%v, %v) = %d, want %d", tc.name, tc.notReady, tc.ready, got, tc.want)
+		}
+	}
+}
+
+func TestContainsFailure(t *testing.T) {
+	if !containsFailure([]string{`check "ci" is failure`}) {
+		t.Error("containsFailure: expected true for a reason mentioning failure")
+	}
+	if containsFailure([]string{"needs 1 approval(s), has 0"}) {
+		t.Error("containsFailure: expected false when no reason mentions failure")
+	}
+}
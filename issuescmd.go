@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/issues"
+)
+
+// printClosesOnMerge prints the "Closes on merge:" section of prStatus. It's
+// a no-op for forges that don't implement forge.IssueFetcher.
+func printClosesOnMerge(f forge.Forge, repo string, pr forge.PR) {
+	refs := issues.ParsePRFixes(pr.Body)
+	if len(refs) == 0 {
+		return
+	}
+	fetcher, ok := f.(forge.IssueFetcher)
+	if !ok {
+		return
+	}
+
+	fmt.Println("Closes on merge:")
+	for _, ref := range refs {
+		issueRepo := repo
+		if ref.Owner != "" && ref.Repo != "" {
+			issueRepo = ref.Owner + "/" + ref.Repo
+		}
+		info, err := fetcher.FetchIssue(issueRepo, ref.Number)
+		if err != nil {
+			fmt.Printf("  - %s: (error: %v)\n", ref, err)
+			continue
+		}
+		label := link(fmt.Sprintf("%s %s", ref, info.Title), info.URL)
+		if strings.EqualFold(info.State, "closed") {
+			// Already closed: likely a stale reference, flag it in red.
+			fmt.Printf("  - %s %s\n", label, color.HiRedString("(already closed - stale reference?)"))
+		} else {
+			fmt.Printf("  - %s %s\n", label, colorState(info.State))
+		}
+	}
+}
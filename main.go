@@ -2,9 +2,7 @@ package main
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"sort"
@@ -13,19 +11,11 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-)
 
-type PR struct {
-	Number         int    `json:"number"`
-	Title          string `json:"title"`
-	State          string `json:"state"`
-	Draft          bool   `json:"draft"`
-	HTMLURL        string `json:"html_url"`
-	Mergeable      *bool  `json:"mergeable"`
-	MergeableState string `json:"mergeable_state"` // "clean", "blocked", "dirty", "unstable", etc.
-	User           struct{ Login string `json:"login"` } `json:"user"`
-	Head           struct{ SHA string `json:"sha"` }     `json:"head"`
-}
+	"github.com/santileira/github-cli/internal/config"
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/policy"
+)
 
 func getToken() string {
 	t := os.Getenv("GH_TOKEN")
@@ -39,23 +29,6 @@ func getToken() string {
 	return ""
 }
 
-func getJSON(url, token string, target any) error {
-	req, _ := http.NewRequest("GET", url, nil)
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
-	}
-	req.Header.Set("Accept", "application/vnd.github+json")
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer res.Body.Close()
-	if res.StatusCode >= 300 {
-		return fmt.Errorf("GitHub API error: %s", res.Status)
-	}
-	return json.NewDecoder(res.Body).Decode(target)
-}
-
 func colorState(s string) string {
 	sl := strings.ToLower(s)
 	switch sl {
@@ -78,147 +51,38 @@ func link(text, url string) string {
 	return fmt.Sprintf("\x1b]8;;%s\x1b\\%s\x1b]8;;\x1b\\", url, text)
 }
 
-// ---- Merge-ready evaluation helpers ----
-
-func latestReviewSummary(repo, prNum, token string) (approved bool, changesRequested bool, err error) {
-	var reviews []struct {
-		User  struct{ Login string `json:"login"` } `json:"user"`
-		State string `json:"state"`
-		// submitted_at not needed; API returns in order, but weâ€™ll collapse by user
-	}
-	if err = getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/reviews", repo, prNum), token, &reviews); err != nil {
-		return
-	}
-	latest := map[string]string{}
-	for _, r := range reviews {
-		latest[r.User.Login] = strings.ToUpper(r.State) // last one seen wins
-	}
-	for _, st := range latest {
-		switch st {
-		case "APPROVED":
-			approved = true
-		case "CHANGES_REQUESTED":
-			changesRequested = true
-		}
-	}
-	// Also count requested reviewers (pending review)
-	var reqRev struct {
-		Users []struct{ Login string `json:"login"` } `json:"users"`
-	}
-	_ = getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/requested_reviewers", repo, prNum), token, &reqRev)
-	// pending reviewers don't block merge-ready if branch rules don't require them,
-	// but usually they do. We *donâ€™t* block on pending here; you can change this if needed.
-	return
-}
-
-func checksAllGreen(repo, sha, token string) (green bool, err error) {
-	var checks struct {
-		CheckRuns []struct {
-			Name       string `json:"name"`
-			Status     string `json:"status"`     // queued, in_progress, completed
-			Conclusion string `json:"conclusion"` // success, failure, cancelled, skipped, neutral, timed_out, action_required
-		} `json:"check_runs"`
-	}
-	if err = getJSON(fmt.Sprintf("https://api.github.com/repos/%s/commits/%s/check-runs", repo, sha), token, &checks); err != nil {
-		return
-	}
-	for _, c := range checks.CheckRuns {
-		if strings.ToLower(c.Status) != "completed" {
-			return false, nil
-		}
-		switch strings.ToLower(c.Conclusion) {
-		case "success", "neutral", "skipped":
-			// ok
-		default:
-			// failure, cancelled, timed_out, action_required, etc.
-			return false, nil
-		}
-	}
-	return true, nil
-}
-
-func canMergeNow(pr PR, reviewsApproved bool, reviewsChangesRequested bool, checksGreen bool) bool {
-	if strings.ToLower(pr.State) != "open" {
-		return false
-	}
-	if pr.Mergeable == nil || !*pr.Mergeable {
-		// Some repos set mergeable late; rely primarily on mergeable_state
-	}
-	if strings.ToLower(pr.MergeableState) != "clean" {
-		return false
-	}
-	if reviewsChangesRequested {
-		return false
-	}
-	if !reviewsApproved {
-		return false
-	}
-	if !checksGreen {
-		return false
-	}
-	return true
-}
-
 // ---- Presentation ----
 
-func prStatus(repo, prNum, author, token string) error {
+func prStatus(f forge.Forge, repo, prNum, author string) error {
 	if prNum != "" {
-		var pr PR
-		if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s", repo, prNum), token, &pr); err != nil {
+		pr, err := f.GetPR(repo, prNum)
+		if err != nil {
 			return err
 		}
 
 		// Make the PR number clickable
-		fmt.Printf("%s %s (%s)\n", link(fmt.Sprintf("#%d", pr.Number), pr.HTMLURL), pr.Title, colorState(pr.State))
-		fmt.Printf("Author: %s\n", pr.User.Login)
+		fmt.Printf("%s %s (%s)\n", link(fmt.Sprintf("#%d", pr.Number), pr.URL), pr.Title, colorState(pr.State))
+		fmt.Printf("Author: %s\n", pr.Author)
 
 		// --- Reviewers (submitted + requested) ---
-		var reviews []struct {
-			User  struct{ Login string `json:"login"` } `json:"user"`
-			State string `json:"state"`
-		}
-		if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/reviews", repo, prNum), token, &reviews); err == nil {
+		if reviews, err := f.ListReviews(repo, prNum); err == nil {
 			fmt.Println("Reviewers:")
-			seen := map[string]string{}
 			for _, r := range reviews {
-				seen[r.User.Login] = r.State
-			}
-			var reqRev struct {
-				Users []struct{ Login string `json:"login"` } `json:"users"`
-				Teams []struct{ Name string `json:"name"` }   `json:"teams"`
-			}
-			_ = getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s/requested_reviewers", repo, prNum), token, &reqRev)
-			for _, u := range reqRev.Users {
-				seen[u.Login] = "requested"
-			}
-			for name, st := range seen {
-				fmt.Printf("  - %s (%s)\n", name, colorState(st))
-			}
-			for _, t := range reqRev.Teams {
-				fmt.Printf("  - Team: %s (%s)\n", t.Name, color.YellowString("requested"))
+				fmt.Printf("  - %s (%s)\n", r.User, colorState(r.State))
 			}
 		}
 
-		// --- GitHub Actions (Checks) ---
-		var checks struct {
-			CheckRuns []struct {
-				Name       string `json:"name"`
-				Status     string `json:"status"`
-				Conclusion string `json:"conclusion"`
-				HTMLURL    string `json:"html_url"`
-			} `json:"check_runs"`
-		}
-
-		if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/commits/%s/check-runs", repo, pr.Head.SHA), token, &checks); err == nil {
+		// --- Checks ---
+		if checks, err := f.ListChecks(repo, pr.HeadSHA); err == nil {
 			type row struct{ Name, Status, URL string }
-			rows := make([]row, 0, len(checks.CheckRuns))
+			rows := make([]row, 0, len(checks))
 
-			for _, c := range checks.CheckRuns {
+			for _, c := range checks {
 				st := c.Conclusion
 				if st == "" {
 					st = c.Status
 				}
-				rows = append(rows, row{Name: c.Name, Status: st, URL: c.HTMLURL})
+				rows = append(rows, row{Name: c.Name, Status: st, URL: c.URL})
 			}
 
 			// Sort by priority: failures first, then skipped/neutral/in_progress, success last
@@ -243,12 +107,14 @@ func prStatus(repo, prNum, author, token string) error {
 				return rows[i].Name < rows[j].Name
 			})
 
-			fmt.Println("GitHub Actions:")
+			fmt.Println("Checks:")
 			for _, r := range rows {
 				clickableName := link(r.Name, r.URL)
 				fmt.Printf("  - %s: %s\n", clickableName, colorState(r.Status))
 			}
 		}
+
+		printClosesOnMerge(f, repo, pr)
 		return nil
 	}
 
@@ -256,22 +122,16 @@ func prStatus(repo, prNum, author, token string) error {
 	if author == "" {
 		return fmt.Errorf("need --pr or --author")
 	}
-
-	var data struct {
-		Items []struct {
-			Number  int    `json:"number"`
-			Title   string `json:"title"`
-			State   string `json:"state"`
-			HTMLURL string `json:"html_url"`
-		} `json:"items"`
+	searcher, ok := f.(forge.AuthorSearcher)
+	if !ok {
+		return fmt.Errorf("listing PRs by author is only supported on GitHub right now; pass --pr")
 	}
-	q := fmt.Sprintf("repo:%s+is:pr+author:%s", repo, author)
-	url := fmt.Sprintf("https://api.github.com/search/issues?q=%s", strings.ReplaceAll(q, "+", "%20"))
-	if err := getJSON(url, token, &data); err != nil {
+	items, err := searcher.SearchOpenByAuthor(repo, author)
+	if err != nil {
 		return err
 	}
-	for _, it := range data.Items {
-		fmt.Printf("%s %s (%s)\n", link(fmt.Sprintf("#%d", it.Number), it.HTMLURL), it.Title, colorState(it.State))
+	for _, it := range items {
+		fmt.Printf("%s %s (%s)\n", link(fmt.Sprintf("#%d", it.Number), it.URL), it.Title, colorState(it.State))
 	}
 	return nil
 }
@@ -296,8 +156,8 @@ func notifyITerm(message string) {
 }
 
 func main() {
-	var repo, prNum, author string
-	var watch bool
+	var repo, prNum, author, forgeFlag, query string
+	var watch, tui bool
 
 	root := &cobra.Command{
 		Use:   "ghprs <repo>",
@@ -308,7 +168,10 @@ func main() {
 	cmd.Flags().StringVar(&repo, "repo", "", "owner/repo (overrides positional)")
 	cmd.Flags().StringVar(&prNum, "pr", "", "PR number")
 	cmd.Flags().StringVar(&author, "author", "", "author login")
+	cmd.Flags().StringVar(&query, "query", "", "saved search query for the TUI's PR list (e.g. \"label:auto-merge\")")
+	cmd.Flags().StringVar(&forgeFlag, "forge", "", "forge backend: github, gitlab, gitea, bitbucket (default: auto-detect from repo)")
 	cmd.Flags().BoolVar(&watch, "watch", false, "refresh every minute (and notify when merge-ready)")
+	cmd.Flags().BoolVar(&tui, "tui", false, "interactive dashboard (bubbletea)")
 
 	cmd.RunE = func(_ *cobra.Command, args []string) error {
 		if repo == "" {
@@ -319,6 +182,33 @@ func main() {
 			return fmt.Errorf("missing GH_TOKEN and no gh auth token available")
 		}
 
+		kind := forge.Kind(strings.ToLower(forgeFlag))
+		if kind == "" {
+			kind = forge.Detect(repo)
+		}
+		repo = forge.StripHost(repo)
+
+		if tui {
+			listQuery := query
+			if listQuery == "" && author != "" {
+				listQuery = "author:" + author
+			}
+			f, err := forge.New(kind, token)
+			if err != nil {
+				return err
+			}
+			return runTUI(kind, f, repo, listQuery)
+		}
+		f, err := forge.New(kind, token)
+		if err != nil {
+			return err
+		}
+
+		mergePolicy, err := config.LoadPolicy()
+		if err != nil {
+			return fmt.Errorf("loading merge policy: %w", err)
+		}
+
 		// Track last "ready to merge" state to avoid spamming
 		lastReady := false
 
@@ -338,14 +228,14 @@ func main() {
 
 			// Fetch PR fresh for readiness detection
 			if prNum != "" {
-				var pr PR
-				if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s", repo, prNum), token, &pr); err == nil {
-					approved, changesReq, _ := latestReviewSummary(repo, prNum, token)
-					green, _ := checksAllGreen(repo, pr.Head.SHA, token)
-					ready := canMergeNow(pr, approved, changesReq, green)
+				pr, err := f.GetPR(repo, prNum)
+				if err == nil {
+					reviews, _ := f.ListReviews(repo, prNum)
+					checks, _ := f.ListChecks(repo, pr.HeadSHA)
+					ready, notReady := policy.Evaluate(mergePolicy, pr, reviews, checks)
 
 					// Show full status
-					_ = prStatus(repo, prNum, author, token)
+					_ = prStatus(f, repo, prNum, author)
 
 					if ready && !lastReady {
 						msg := fmt.Sprintf("PR #%d is READY to merge âœ…", pr.Number)
@@ -364,7 +254,10 @@ func main() {
 							fmt.Println(color.HiGreenString("ðŸŽ‰ PR is READY to merge!"))
 							fmt.Println(color.HiCyanString("Type 'merge' to merge now"))
 						} else {
-							fmt.Println(color.YellowString("â³ Waiting for PR to be ready..."))
+							fmt.Println(color.YellowString("â³ Waiting for PR to be ready:"))
+							for _, reason := range notReady {
+								fmt.Println(color.YellowString("  â€¢ " + reason))
+							}
 							fmt.Println(color.HiCyanString("Type 'merge' to attempt merge anyway"))
 						}
 					}
@@ -373,70 +266,72 @@ func main() {
 				}
 			} else {
 				// Author listing path
-				_ = prStatus(repo, prNum, author, token)
+				_ = prStatus(f, repo, prNum, author)
 			}
 
 			if !watch {
 				break
 			}
 
-			fmt.Println(time.Now().Format("15:04:05"), "â³ refreshing in 1m...")
+			wait := time.Minute
+			rl := forge.LastRateLimit()
+			quota := ""
+			if rl.Limit > 0 {
+				quota = fmt.Sprintf(" | rate limit: %d/%d, resets %s", rl.Remaining, rl.Limit, rl.Reset.Format("15:04:05"))
+				if rl.ShouldBackOff() {
+					wait = 5 * time.Minute
+					quota += " (low quota, backing off)"
+				}
+			}
+			fmt.Println(time.Now().Format("15:04:05"), "â³ refreshing in", wait, "..."+quota)
 
 			// Wait for either timeout or user command
 			select {
 			case cmd := <-userCmd:
 				if strings.ToLower(cmd) == "merge" {
 					// Fetch latest PR state
-					var pr PR
-					if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s", repo, prNum), token, &pr); err != nil {
+					pr, err := f.GetPR(repo, prNum)
+					if err != nil {
 						fmt.Println(color.HiRedString("âŒ Error fetching PR: %v", err))
 						time.Sleep(3 * time.Second)
 						continue
 					}
 
-					approved, changesReq, _ := latestReviewSummary(repo, prNum, token)
-					green, _ := checksAllGreen(repo, pr.Head.SHA, token)
-					ready := canMergeNow(pr, approved, changesReq, green)
+					reviews, _ := f.ListReviews(repo, prNum)
+					checks, _ := f.ListChecks(repo, pr.HeadSHA)
+					ready, notReady := policy.Evaluate(mergePolicy, pr, reviews, checks)
 
 					if !ready {
 						fmt.Println(color.HiRedString("\nâŒ PR is NOT ready to merge:"))
-						if strings.ToLower(pr.State) != "open" {
-							fmt.Printf("  â€¢ PR is %s (must be open)\n", pr.State)
-						}
-						if strings.ToLower(pr.MergeableState) != "clean" {
-							fmt.Printf("  â€¢ Mergeable state: %s (must be clean)\n", pr.MergeableState)
-						}
-						if changesReq {
-							fmt.Println("  â€¢ Changes requested by reviewers")
-						}
-						if !approved {
-							fmt.Println("  â€¢ Missing required approvals")
-						}
-						if !green {
-							fmt.Println("  â€¢ Checks are not all passing")
+						for _, reason := range notReady {
+							fmt.Printf("  â€¢ %s\n", reason)
 						}
 						fmt.Println("\nPress Enter to continue watching...")
 						time.Sleep(5 * time.Second)
 						continue
 					}
 
-					// Execute merge with squash
-					fmt.Println(color.HiGreenString("\nâœ… Merging PR #%d with squash...", pr.Number))
-					mergeCmd := exec.Command("gh", "pr", "merge", prNum, "--repo", repo, "--squash", "--auto", "--delete-branch")
-					mergeCmd.Stdout = os.Stdout
-					mergeCmd.Stderr = os.Stderr
-					if err := mergeCmd.Run(); err != nil {
+					// Prefer squash, but fall back to whatever the policy allows.
+					method := forge.MergeSquash
+					if !mergePolicy.Allows(method) {
+						method = forge.MergeMerge
+						if !mergePolicy.Allows(method) {
+							method = forge.MergeRebase
+						}
+					}
+					fmt.Println(color.HiGreenString("\nâœ… Merging PR #%d (%s)...", pr.Number, method))
+					if err := f.Merge(repo, prNum, method); err != nil {
 						fmt.Println(color.HiRedString("âŒ Merge failed: %v", err))
 						time.Sleep(3 * time.Second)
 						continue
 					}
 
-					fmt.Println(color.HiGreenString("âœ… Squash merge completed successfully!"))
+					fmt.Println(color.HiGreenString("âœ… Merge completed successfully!"))
 					return nil
 				} else if strings.ToLower(cmd) == "ready" {
 					// Fetch latest PR state
-					var pr PR
-					if err := getJSON(fmt.Sprintf("https://api.github.com/repos/%s/pulls/%s", repo, prNum), token, &pr); err != nil {
+					pr, err := f.GetPR(repo, prNum)
+					if err != nil {
 						fmt.Println(color.HiRedString("âŒ Error fetching PR: %v", err))
 						time.Sleep(3 * time.Second)
 						continue
@@ -444,17 +339,14 @@ func main() {
 
 					// Check if PR is a draft
 					if !pr.Draft {
-						fmt.Println(color.HiYellowString("\nâš ï¸  PR is already ready for review (not a draft)"))
+						fmt.Println(color.HiYellowString("\nâš ï¸  PR is already ready for review (not a draft)"))
 						time.Sleep(3 * time.Second)
 						continue
 					}
 
 					// Execute ready command
 					fmt.Println(color.HiGreenString("\nâœ… Marking PR #%d as ready for review...", pr.Number))
-					readyCmd := exec.Command("gh", "pr", "ready", prNum, "--repo", repo)
-					readyCmd.Stdout = os.Stdout
-					readyCmd.Stderr = os.Stderr
-					if err := readyCmd.Run(); err != nil {
+					if err := f.MarkReady(repo, prNum); err != nil {
 						fmt.Println(color.HiRedString("âŒ Failed to mark PR as ready: %v", err))
 						time.Sleep(3 * time.Second)
 						continue
@@ -463,7 +355,7 @@ func main() {
 					fmt.Println(color.HiGreenString("âœ… PR is now ready for review!"))
 					time.Sleep(2 * time.Second)
 				}
-			case <-time.After(time.Minute):
+			case <-time.After(wait):
 				// Continue to next iteration
 			}
 		}
@@ -471,6 +363,7 @@ func main() {
 	}
 
 	root.AddCommand(cmd)
+	root.AddCommand(newQueueCmd())
 	if err := root.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/santileira/github-cli/internal/config"
+	"github.com/santileira/github-cli/internal/forge"
+	"github.com/santileira/github-cli/internal/queue"
+)
+
+// newQueueCmd builds the `ghprs queue` subcommand: a submit-queue style
+// batch watcher that watches a set of PRs concurrently and merges each one
+// as soon as it becomes merge-ready.
+func newQueueCmd() *cobra.Command {
+	var repo, forgeFlag, query, method string
+	var minApprovals int
+	var requiredChecks []string
+	var staleAfter time.Duration
+	var interval time.Duration
+	var jsonOut bool
+	var once bool
+
+	cmd := &cobra.Command{
+		Use:   "queue [pr-numbers...]",
+		Short: "Watch and auto-merge a batch of PRs as they become ready",
+	}
+	cmd.Flags().StringVar(&repo, "repo", "", "owner/repo")
+	cmd.Flags().StringVar(&forgeFlag, "forge", "", "forge backend: github, gitlab, gitea, bitbucket (default: auto-detect from repo)")
+	cmd.Flags().StringVar(&query, "query", "", "search query instead of explicit PR numbers, e.g. label:auto-merge author:me (GitHub only)")
+	cmd.Flags().StringVar(&method, "method", "squash", "merge method: merge, squash, rebase")
+	cmd.Flags().IntVar(&minApprovals, "min-approvals", 1, "minimum number of approvals required (overrides config file)")
+	cmd.Flags().StringSliceVar(&requiredChecks, "required-check", nil, "required check context (repeatable); overrides config file; if neither sets any, all checks are required")
+	cmd.Flags().DurationVar(&staleAfter, "stale-after", 0, "re-trigger CI if the last green run is older than this (0 disables)")
+	cmd.Flags().DurationVar(&interval, "interval", time.Minute, "poll interval")
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "print status as JSON (for embedding in dashboards)")
+	cmd.Flags().BoolVar(&once, "once", false, "evaluate once and exit instead of watching")
+
+	cmd.RunE = func(cc *cobra.Command, args []string) error {
+		if repo == "" {
+			return fmt.Errorf("--repo is required")
+		}
+		if len(args) == 0 && query == "" {
+			return fmt.Errorf("need PR numbers or --query")
+		}
+
+		token := getToken()
+		if token == "" {
+			return fmt.Errorf("missing GH_TOKEN and no gh auth token available")
+		}
+
+		kind := forge.Kind(strings.ToLower(forgeFlag))
+		if kind == "" {
+			kind = forge.Detect(repo)
+		}
+		repo = forge.StripHost(repo)
+		f, err := forge.New(kind, token)
+		if err != nil {
+			return err
+		}
+
+		if query != "" && kind != forge.GitHub {
+			return fmt.Errorf("--query is only supported for GitHub currently")
+		}
+		numbers := args
+		if query != "" {
+			found, err := searchGitHubPRNumbers(repo, query)
+			if err != nil {
+				return err
+			}
+			numbers = found
+		}
+
+		mergeMethod := forge.MergeMethod(method)
+
+		mergePolicy, err := config.LoadPolicy()
+		if err != nil {
+			return fmt.Errorf("loading merge policy: %w", err)
+		}
+		// CLI flags override whatever the config file says, but only the
+		// ones the user actually passed - an unset flag shouldn't stomp a
+		// file-configured value with its zero-value default.
+		if cc.Flags().Changed("min-approvals") {
+			mergePolicy.MinApprovals = minApprovals
+		}
+		if cc.Flags().Changed("required-check") {
+			mergePolicy.RequiredChecks = requiredChecks
+		}
+
+		q := queue.New(f, queue.Policy{
+			Policy:     mergePolicy,
+			StaleAfter: staleAfter,
+		}, mergeMethod)
+		if staleAfter > 0 {
+			q.OnStale = func(repo string, pr forge.PR) error {
+				fmt.Printf("PR #%d's last green CI is stale; re-triggering checks\n", pr.Number)
+				return triggerStaleChecks(repo, fmt.Sprint(pr.Number))
+			}
+		}
+		watched := map[string]bool{}
+		for _, n := range numbers {
+			q.Add(repo, n)
+			watched[n] = true
+		}
+
+		for {
+			q.Tick()
+			printQueueStatus(q, jsonOut)
+			if once {
+				return nil
+			}
+			if query != "" {
+				if err := addNewlyMatching(q, repo, query, watched); err != nil {
+					fmt.Printf("re-running --query: %v\n", err)
+				}
+			}
+			time.Sleep(interval)
+		}
+	}
+	return cmd
+}
+
+func printQueueStatus(q *queue.Queue, jsonOut bool) {
+	statuses := q.Status()
+	if jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(statuses)
+		return
+	}
+	for _, s := range statuses {
+		switch {
+		case s.Error != "":
+			fmt.Printf("#%s: error: %s\n", s.Number, s.Error)
+		case s.Merged:
+			fmt.Printf("#%s: merged\n", s.Number)
+		case s.Ready:
+			fmt.Printf("#%s: ready (merging)\n", s.Number)
+		default:
+			fmt.Printf("#%s: not ready - %s\n", s.Number, strings.Join(s.NotReady, "; "))
+		}
+	}
+}
+
+// searchGitHubPRNumbers resolves a GitHub search query (e.g.
+// "label:auto-merge author:me") to a list of PR numbers within repo. This
+// isn't part of the Forge interface (it's a GitHub-specific convenience),
+// so it shells out to `gh` the same way the original merge/ready commands
+// did.
+func searchGitHubPRNumbers(repo, query string) ([]string, error) {
+	out, err := exec.Command("gh", "pr", "list", "--repo", repo, "--search", query, "--json", "number").Output()
+	if err != nil {
+		return nil, fmt.Errorf("gh pr list: %w", err)
+	}
+	var found []struct {
+		Number int `json:"number"`
+	}
+	if err := json.Unmarshal(out, &found); err != nil {
+		return nil, err
+	}
+	numbers := make([]string, 0, len(found))
+	for _, f := range found {
+		numbers = append(numbers, fmt.Sprint(f.Number))
+	}
+	return numbers, nil
+}
+
+// addNewlyMatching re-runs query and adds any PR not already in watched to
+// q, so a PR that starts matching after the queue started (e.g. gets the
+// label that --query filters on) is picked up on a later Tick instead of
+// only at startup.
+func addNewlyMatching(q *queue.Queue, repo, query string, watched map[string]bool) error {
+	found, err := searchGitHubPRNumbers(repo, query)
+	if err != nil {
+		return err
+	}
+	for _, n := range found {
+		if watched[n] {
+			continue
+		}
+		q.Add(repo, n)
+		watched[n] = true
+	}
+	return nil
+}
+
+// triggerStaleChecks re-kicks CI for a PR whose last green run is stale by
+// posting a comment, then waits for its checks to finish via
+// `gh pr checks --watch`.
+func triggerStaleChecks(repo, prNum string) error {
+	_ = exec.Command("gh", "pr", "comment", prNum, "--repo", repo, "--body", "Re-triggering CI: last green run is stale.").Run()
+	return exec.Command("gh", "pr", "checks", prNum, "--repo", repo, "--watch").Run()
+}
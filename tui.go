@@ -0,0 +1,361 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/santileira/github-cli/internal/forge"
+)
+
+func parseGHPRList(out []byte) ([]prListItem, error) {
+	var raw []struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		State  string `json:"state"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return nil, err
+	}
+	items := make([]prListItem, 0, len(raw))
+	for _, r := range raw {
+		items = append(items, prListItem{number: r.Number, title: r.Title, state: r.State})
+	}
+	return items, nil
+}
+
+// runTUI launches the bubbletea dashboard for `ghprs status --tui`. The PR
+// list is fetched via `gh pr list`, which only understands GitHub.
+func runTUI(kind forge.Kind, f forge.Forge, repo, listQuery string) error {
+	if kind != forge.GitHub {
+		return fmt.Errorf("--tui is only supported for GitHub right now")
+	}
+	m := newTUIModel(f, repo, listQuery)
+	p := tea.NewProgram(m, tea.WithAltScreen())
+	_, err := p.Run()
+	return err
+}
+
+// ---- messages ----
+
+type prListMsg struct {
+	items []prListItem
+	err   error
+}
+
+type prListItem struct {
+	number int
+	title  string
+	state  string
+}
+
+type prDetailMsg struct {
+	number  int
+	pr      forge.PR
+	reviews []forge.Review
+	checks  []forge.Check
+	err     error
+}
+
+type actionDoneMsg struct {
+	note string
+	err  error
+}
+
+// ---- model ----
+
+type tuiMode int
+
+const (
+	modeList tuiMode = iota
+	modeFilter
+	modeMergeChoice
+)
+
+type tuiModel struct {
+	forge     forge.Forge
+	repo      string
+	listQuery string
+
+	mode tuiMode
+
+	items    []prListItem
+	filtered []prListItem
+	filter   string
+	selected int
+
+	detail     prDetailMsg
+	haveDetail bool
+	loading    bool
+	status     string
+
+	width, height int
+}
+
+func newTUIModel(f forge.Forge, repo, listQuery string) tuiModel {
+	return tuiModel{forge: f, repo: repo, listQuery: listQuery, mode: modeList}
+}
+
+func (m tuiModel) Init() tea.Cmd {
+	return m.fetchList()
+}
+
+// fetchList asynchronously loads the PR list by shelling out to `gh pr list`.
+func (m tuiModel) fetchList() tea.Cmd {
+	repo, query := m.repo, m.listQuery
+	return func() tea.Msg {
+		args := []string{"pr", "list", "--repo", repo, "--json", "number,title,state", "--limit", "100"}
+		if query != "" {
+			args = append(args, "--search", query)
+		}
+		out, err := exec.Command("gh", args...).Output()
+		if err != nil {
+			return prListMsg{err: fmt.Errorf("gh pr list: %w", err)}
+		}
+		items, err := parseGHPRList(out)
+		return prListMsg{items: items, err: err}
+	}
+}
+
+func (m tuiModel) fetchDetail(number int) tea.Cmd {
+	f, repo := m.forge, m.repo
+	return func() tea.Msg {
+		pr, err := f.GetPR(repo, fmt.Sprint(number))
+		if err != nil {
+			return prDetailMsg{number: number, err: err}
+		}
+		reviews, _ := f.ListReviews(repo, fmt.Sprint(number))
+		checks, _ := f.ListChecks(repo, pr.HeadSHA)
+		return prDetailMsg{number: number, pr: pr, reviews: reviews, checks: checks}
+	}
+}
+
+func (m tuiModel) doMerge(number int, method forge.MergeMethod) tea.Cmd {
+	f, repo := m.forge, m.repo
+	return func() tea.Msg {
+		err := f.Merge(repo, fmt.Sprint(number), method)
+		return actionDoneMsg{note: fmt.Sprintf("merge (%s) #%d", method, number), err: err}
+	}
+}
+
+func (m tuiModel) doMarkReady(number int) tea.Cmd {
+	f, repo := m.forge, m.repo
+	return func() tea.Msg {
+		err := f.MarkReady(repo, fmt.Sprint(number))
+		return actionDoneMsg{note: fmt.Sprintf("mark ready #%d", number), err: err}
+	}
+}
+
+func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case prListMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.status = msg.err.Error()
+			return m, nil
+		}
+		m.items = msg.items
+		m.applyFilter()
+		if cur, ok := m.current(); ok {
+			return m, m.fetchDetail(cur.number)
+		}
+		return m, nil
+
+	case prDetailMsg:
+		if msg.err != nil {
+			m.status = msg.err.Error()
+			return m, nil
+		}
+		m.detail = msg
+		m.haveDetail = true
+		return m, nil
+
+	case actionDoneMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("%s failed: %v", msg.note, msg.err)
+		} else {
+			m.status = msg.note + " OK"
+		}
+		return m, m.fetchList()
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+	return m, nil
+}
+
+func (m tuiModel) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.mode == modeFilter {
+		switch msg.String() {
+		case "enter", "esc":
+			m.mode = modeList
+			return m, nil
+		case "backspace":
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+			m.applyFilter()
+			return m, nil
+		default:
+			if len(msg.Runes) == 1 {
+				m.filter += string(msg.Runes)
+				m.applyFilter()
+			}
+			return m, nil
+		}
+	}
+
+	if m.mode == modeMergeChoice {
+		var method forge.MergeMethod
+		switch msg.String() {
+		case "s":
+			method = forge.MergeSquash
+		case "m":
+			method = forge.MergeMerge
+		case "r":
+			method = forge.MergeRebase
+		case "esc":
+			m.mode = modeList
+			return m, nil
+		default:
+			return m, nil
+		}
+		m.mode = modeList
+		if cur, ok := m.current(); ok {
+			return m, m.doMerge(cur.number, method)
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.selected > 0 {
+			m.selected--
+			return m, m.fetchDetail(m.filtered[m.selected].number)
+		}
+	case "down", "j":
+		if m.selected < len(m.filtered)-1 {
+			m.selected++
+			return m, m.fetchDetail(m.filtered[m.selected].number)
+		}
+	case "/":
+		m.mode = modeFilter
+	case "m":
+		m.mode = modeMergeChoice
+	case "r":
+		if cur, ok := m.current(); ok {
+			return m, m.doMarkReady(cur.number)
+		}
+	case "o":
+		if cur, ok := m.current(); ok {
+			_ = exec.Command("gh", "pr", "view", fmt.Sprint(cur.number), "--repo", m.repo, "--web").Start()
+		}
+	case "R":
+		m.loading = true
+		return m, m.fetchList()
+	}
+	return m, nil
+}
+
+func (m *tuiModel) applyFilter() {
+	m.filtered = m.filtered[:0]
+	for _, it := range m.items {
+		if m.filter == "" || strings.Contains(strings.ToLower(it.title), strings.ToLower(m.filter)) {
+			m.filtered = append(m.filtered, it)
+		}
+	}
+	if m.selected >= len(m.filtered) {
+		m.selected = len(m.filtered) - 1
+	}
+	if m.selected < 0 {
+		m.selected = 0
+	}
+}
+
+func (m tuiModel) current() (prListItem, bool) {
+	if m.selected < 0 || m.selected >= len(m.filtered) {
+		return prListItem{}, false
+	}
+	return m.filtered[m.selected], true
+}
+
+// ---- view ----
+
+var (
+	listStyle     = lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.NormalBorder()).BorderForeground(lipgloss.Color("240"))
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212"))
+	statusStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+)
+
+func (m tuiModel) View() string {
+	left := m.renderList()
+	right := m.renderDetail()
+	body := lipgloss.JoinHorizontal(lipgloss.Top, listStyle.Render(left), listStyle.Render(right))
+
+	help := "m merge  r ready  o open  / filter  R refresh  q quit"
+	if m.mode == modeFilter {
+		help = fmt.Sprintf("filter: %s_", m.filter)
+	} else if m.mode == modeMergeChoice {
+		help = "merge as: s squash  m merge  r rebase  esc cancel"
+	}
+
+	footer := statusStyle.Render(help)
+	if m.status != "" {
+		footer += "\n" + statusStyle.Render(m.status)
+	}
+	return body + "\n" + footer
+}
+
+func (m tuiModel) renderList() string {
+	var b strings.Builder
+	b.WriteString("PRs\n")
+	for i, it := range m.filtered {
+		line := fmt.Sprintf("#%d %s (%s)", it.number, it.title, it.state)
+		if i == m.selected {
+			line = selectedStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	if len(m.filtered) == 0 {
+		b.WriteString("  (none)\n")
+	}
+	return b.String()
+}
+
+func (m tuiModel) renderDetail() string {
+	if !m.haveDetail {
+		return "Loading..."
+	}
+	d := m.detail
+	var b strings.Builder
+	fmt.Fprintf(&b, "#%d %s\n", d.pr.Number, d.pr.Title)
+	fmt.Fprintf(&b, "State: %s  Draft: %v\n", d.pr.State, d.pr.Draft)
+	fmt.Fprintf(&b, "Mergeable: %s\n\n", d.pr.MergeableState)
+
+	b.WriteString("Reviewers:\n")
+	for _, r := range d.reviews {
+		fmt.Fprintf(&b, "  - %s (%s)\n", r.User, r.State)
+	}
+
+	b.WriteString("\nChecks:\n")
+	for _, c := range d.checks {
+		st := c.Conclusion
+		if st == "" {
+			st = c.Status
+		}
+		fmt.Fprintf(&b, "  - %s: %s\n", c.Name, st)
+	}
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/santileira/github-cli/internal/forge"
+)
+
+type fakeTUIForge struct{}
+
+func (fakeTUIForge) GetPR(repo, number string) (forge.PR, error) {
+	return forge.PR{Number: 0}, nil
+}
+func (fakeTUIForge) ListReviews(repo, number string) ([]forge.Review, error)   { return nil, nil }
+func (fakeTUIForge) ListChecks(repo, sha string) ([]forge.Check, error)        { return nil, nil }
+func (fakeTUIForge) Merge(repo, number string, method forge.MergeMethod) error { return nil }
+func (fakeTUIForge) MarkReady(repo, number string) error                       { return nil }
+
+func TestApplyFilterClampsSelected(t *testing.T) {
+	m := tuiModel{
+		items:    []prListItem{{number: 1}, {number: 2}, {number: 3}},
+		selected: 2,
+	}
+	m.filter = "nomatch"
+	m.applyFilter()
+	if m.selected != 0 {
+		t.Errorf("selected after filtering everything out = %d, want 0", m.selected)
+	}
+
+	m.filter = ""
+	m.selected = 5
+	m.applyFilter()
+	if m.selected != len(m.filtered)-1 {
+		t.Errorf("selected after out-of-range = %d, want %d", m.selected, len(m.filtered)-1)
+	}
+}
+
+func TestUpdatePRListMsgFetchesDetailForSelectedRow(t *testing.T) {
+	m := newTUIModel(fakeTUIForge{}, "o/r", "")
+	m.selected = 1
+
+	updated, cmd := m.Update(prListMsg{items: []prListItem{{number: 10}, {number: 20}, {number: 30}}})
+	nm := updated.(tuiModel)
+	if nm.selected != 1 {
+		t.Fatalf("selected after reload = %d, want 1 (preserved)", nm.selected)
+	}
+	if cmd == nil {
+		t.Fatal("expected a fetchDetail command")
+	}
+	msg := cmd().(prDetailMsg)
+	if msg.number != 20 {
+		t.Errorf("fetchDetail targeted PR #%d, want #20 (the selected row, not index 0)", msg.number)
+	}
+}